@@ -0,0 +1,30 @@
+// Package app assembles the `karmadactl` root command out of its
+// subcommands. It lives outside pkg/karmadactl so that subcommands
+// implemented in their own packages (e.g. init, destroy) can import
+// pkg/karmadactl for shared types like KarmadaConfig and CommandUnjoinOption
+// without creating an import cycle back through the root command.
+package app
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl"
+	"github.com/karmada-io/karmada/pkg/karmadactl/destroy"
+	karmadainit "github.com/karmada-io/karmada/pkg/karmadactl/init"
+)
+
+// NewKarmadaCtlCommand is the root command for karmadactl, the Karmada command-line client.
+func NewKarmadaCtlCommand(cmdOut io.Writer, karmadaConfig karmadactl.KarmadaConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "karmadactl",
+		Short: "karmadactl controls the Karmada control plane",
+	}
+
+	cmd.AddCommand(karmadactl.NewCmdUnjoin(cmdOut, karmadaConfig))
+	cmd.AddCommand(karmadainit.NewCmdInit(cmdOut, karmadaConfig))
+	cmd.AddCommand(destroy.NewCmdDestroy(cmdOut, karmadaConfig))
+
+	return cmd
+}
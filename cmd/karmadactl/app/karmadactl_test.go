@@ -0,0 +1,27 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+// fakeKarmadaConfig is a no-op karmadactl.KarmadaConfig used only to exercise
+// command wiring; none of these tests invoke RunE, so GetRestConfig is never called.
+type fakeKarmadaConfig struct{}
+
+func (fakeKarmadaConfig) GetRestConfig(context, kubeconfigPath string) (*rest.Config, error) {
+	return nil, nil
+}
+
+func TestNewKarmadaCtlCommand_RegistersSubcommands(t *testing.T) {
+	cmd := NewKarmadaCtlCommand(&bytes.Buffer{}, fakeKarmadaConfig{})
+
+	want := []string{"unjoin", "init", "destroy"}
+	for _, name := range want {
+		if sub, _, err := cmd.Find([]string{name}); err != nil || sub.Name() != name {
+			t.Errorf("expected root command to expose %q subcommand, err: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Package destroy implements the `karmadactl destroy` command, the reverse
+// of `karmadactl init`.
+package destroy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+const defaultKarmadaSystemNamespace = "karmada-system"
+
+// controlPlaneComponents are the Deployments/Services created by `karmadactl init`.
+var controlPlaneComponents = []string{
+	"karmada-apiserver",
+	"karmada-controller-manager",
+	"karmada-scheduler",
+	"karmada-webhook",
+	"karmada-etcd",
+}
+
+// controlPlaneClusterRole is the cluster-scoped ClusterRole/ClusterRoleBinding name created by
+// `karmadactl init` for the control-plane components. It must be deleted explicitly, unlike the
+// ServiceAccount and cert Secret, which are namespaced and removed along with the namespace.
+const controlPlaneClusterRole = "karmada-controlplane"
+
+var (
+	destroyLong = `Destroy removes the Karmada control plane from the host cluster.`
+
+	destroyExample = `
+karmadactl destroy
+`
+)
+
+// NewCmdDestroy defines the `destroy` command that removes the Karmada control plane from the host cluster.
+func NewCmdDestroy(cmdOut io.Writer, karmadaConfig karmadactl.KarmadaConfig) *cobra.Command {
+	opts := CommandDestroyOption{}
+
+	cmd := &cobra.Command{
+		Use:     "destroy",
+		Short:   "Remove the Karmada control plane from the host cluster",
+		Long:    destroyLong,
+		Example: destroyExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete()
+			return RunDestroy(cmdOut, karmadaConfig, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.AddFlags(flags)
+
+	return cmd
+}
+
+// CommandDestroyOption holds all command options.
+type CommandDestroyOption struct {
+	options.GlobalCommandOptions
+
+	// Context is the host cluster's context in kubeconfig to destroy Karmada on.
+	Context string
+
+	// Force allows destroy to proceed, unjoining them first, even though Cluster objects still exist.
+	Force bool
+
+	// Parallelism is the number of member clusters unjoined concurrently before the control plane is torn down.
+	Parallelism int
+}
+
+// Complete fills in defaults that depend on other options having been parsed.
+func (d *CommandDestroyOption) Complete() {
+	if len(d.ClusterNamespace) == 0 {
+		d.ClusterNamespace = defaultKarmadaSystemNamespace
+	}
+	if d.Parallelism <= 0 {
+		d.Parallelism = 1
+	}
+}
+
+// AddFlags adds flags to the specified FlagSet.
+func (d *CommandDestroyOption) AddFlags(flags *pflag.FlagSet) {
+	d.GlobalCommandOptions.AddFlags(flags)
+
+	flags.StringVar(&d.Context, "context", "",
+		"Context name of the host cluster in kubeconfig.")
+	flags.BoolVar(&d.Force, "force", false,
+		"Unjoin any remaining member clusters and proceed with destroy even though Cluster objects still exist.")
+	flags.IntVar(&d.Parallelism, "parallelism", 3,
+		"Number of member clusters to unjoin concurrently before tearing down the control plane.")
+}
+
+// RunDestroy is the implementation of the 'destroy' command.
+func RunDestroy(cmdOut io.Writer, karmadaConfig karmadactl.KarmadaConfig, opts CommandDestroyOption) error {
+	klog.V(1).Infof("destroying karmada control plane. namespace: %s", opts.ClusterNamespace)
+
+	restConfig, err := karmadaConfig.GetRestConfig(opts.Context, opts.KubeConfig)
+	if err != nil {
+		klog.Errorf("failed to get host cluster rest config. context: %s, kube-config: %s, error: %v",
+			opts.Context, opts.KubeConfig, err)
+		return err
+	}
+
+	karmadaClient := karmadaclientset.NewForConfigOrDie(restConfig)
+	kubeClient := kubeclient.NewForConfigOrDie(restConfig)
+	apiextensionsClient := apiextensionsclientset.NewForConfigOrDie(restConfig)
+
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list cluster objects: %w", err)
+	}
+
+	if len(clusters.Items) > 0 {
+		if !opts.Force {
+			return fmt.Errorf("refusing to destroy: %d cluster(s) are still registered, pass --force to unjoin them first", len(clusters.Items))
+		}
+
+		if err := unjoinRemainingClusters(cmdOut, karmadaConfig, opts, clusters.Items); err != nil {
+			return err
+		}
+	}
+
+	if err := teardownControlPlane(kubeClient, apiextensionsClient, opts.ClusterNamespace, opts.DryRun); err != nil {
+		klog.Errorf("Failed to tear down karmada control plane, error: %v", err)
+		return err
+	}
+
+	fmt.Fprintf(cmdOut, "Karmada control plane destroyed.\n")
+	return nil
+}
+
+// unjoinRemainingClusters unjoins every remaining member cluster, up to opts.Parallelism at a time,
+// reusing the existing `unjoin` implementation. Each cluster's RunUnjoin runs in its own goroutine,
+// so writes to cmdOut are serialized through a shared syncWriter to avoid racing on the underlying writer.
+func unjoinRemainingClusters(cmdOut io.Writer, karmadaConfig karmadactl.KarmadaConfig, opts CommandDestroyOption, clusters []clusterv1alpha1.Cluster) error {
+	sem := make(chan struct{}, opts.Parallelism)
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(clusters))
+	out := &syncWriter{out: cmdOut}
+
+	for i := range clusters {
+		clusterName := clusters[i].Name
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			unjoinOpts := karmadactl.CommandUnjoinOption{
+				GlobalCommandOptions: opts.GlobalCommandOptions,
+				Context:              opts.Context,
+				ClusterName:          clusterName,
+				ClusterContext:       clusterName,
+			}
+			// unjoinRemainingClusters only runs once opts.Force has already been
+			// confirmed above, so propagate it: otherwise a cluster mid-operation
+			// is silently skipped by Validate and destroy tears down the control
+			// plane anyway, orphaning its still-registered Cluster object.
+			unjoinOpts.SetForce(opts.Force)
+
+			klog.Infof("Destroy: unjoining member cluster %q", clusterName)
+			if err := karmadactl.RunUnjoin(out, karmadaConfig, unjoinOpts); err != nil {
+				errCh <- fmt.Errorf("failed to unjoin cluster %q: %w", clusterName, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	var errs []error
+	for e := range errCh {
+		errs = append(errs, e)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncWriter serializes writes from multiple goroutines onto a single io.Writer, so several
+// concurrent RunUnjoin calls can safely share one output stream.
+type syncWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.out.Write(p)
+}
+
+// teardownControlPlane removes the Deployments/Services installed by `init`, every Karmada CRD,
+// and finally the karmada-system namespace itself.
+func teardownControlPlane(kubeClient kubeclient.Interface, apiextensionsClient apiextensionsclientset.Interface, namespace string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	for _, name := range controlPlaneComponents {
+		if err := kubeClient.AppsV1().Deployments(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete deployment %s: %w", name, err)
+		}
+		if err := kubeClient.CoreV1().Services(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete service %s: %w", name, err)
+		}
+	}
+
+	crds, err := apiextensionsClient.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list CRDs: %w", err)
+	}
+	for _, crd := range crds.Items {
+		if !strings.HasSuffix(crd.Spec.Group, "karmada.io") {
+			continue
+		}
+		if err := apiextensionsClient.ApiextensionsV1().CustomResourceDefinitions().Delete(context.TODO(), crd.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete CRD %s: %w", crd.Name, err)
+		}
+	}
+
+	if err := kubeClient.RbacV1().ClusterRoleBindings().Delete(context.TODO(), controlPlaneClusterRole, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete cluster role binding %s: %w", controlPlaneClusterRole, err)
+	}
+	if err := kubeClient.RbacV1().ClusterRoles().Delete(context.TODO(), controlPlaneClusterRole, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete cluster role %s: %w", controlPlaneClusterRole, err)
+	}
+
+	if err := util.DeleteNamespace(kubeClient, namespace); err != nil {
+		return fmt.Errorf("failed to delete namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package karmadainit
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// certSecretName holds the CA and the apiserver/etcd/admin certificate-key
+// pairs that the control-plane components mount to talk to each other over TLS.
+const certSecretName = "karmada-cert"
+
+// certValidity is how long the self-signed CA and serving certificate are valid for.
+const certValidity = 10 * 365 * 24 * time.Hour
+
+// ensureCertsSecret makes sure the karmada-cert Secret exists in namespace,
+// generating a self-signed CA and the apiserver/etcd serving certificates and
+// admin client certificate signed by it if it doesn't. An existing secret is
+// left untouched so re-running `init` doesn't invalidate certificates already
+// trusted by running components.
+func ensureCertsSecret(client kubeclient.Interface, namespace string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	_, err := client.CoreV1().Secrets(namespace).Get(context.TODO(), certSecretName, metav1.GetOptions{})
+	if err == nil {
+		klog.V(1).Infof("Secret %s already exists, skipping certificate generation", certSecretName)
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get secret %s: %w", certSecretName, err)
+	}
+
+	caCertPEM, caKeyPEM, caCert, caKey, err := generateSelfSignedCA()
+	if err != nil {
+		return fmt.Errorf("failed to generate CA certificate: %w", err)
+	}
+
+	apiserverCertPEM, apiserverKeyPEM, err := generateSignedCert(caCert, caKey, "karmada-apiserver", []string{
+		"karmada-apiserver",
+		fmt.Sprintf("karmada-apiserver.%s.svc", namespace),
+		fmt.Sprintf("karmada-apiserver.%s.svc.cluster.local", namespace),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate karmada-apiserver serving certificate: %w", err)
+	}
+
+	// etcd gets its own serving certificate rather than reusing the apiserver's:
+	// the apiserver dials etcd at the "karmada-etcd" service name, which isn't
+	// one of the apiserver certificate's SANs and would fail TLS verification.
+	etcdCertPEM, etcdKeyPEM, err := generateSignedCert(caCert, caKey, etcdServiceName, []string{
+		etcdServiceName,
+		fmt.Sprintf("%s.%s.svc", etcdServiceName, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", etcdServiceName, namespace),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate karmada-etcd serving certificate: %w", err)
+	}
+
+	// admin is the client identity embedded in the control-plane components'
+	// kubeconfig (see kubeconfig.go): "system:masters" is recognized by any
+	// generic apiserver, karmada-apiserver included, as the built-in superuser
+	// group, so it needs no corresponding RBAC objects of its own.
+	adminCertPEM, adminKeyPEM, err := generateSignedClientCert(caCert, caKey, "karmada-admin", []string{"system:masters"})
+	if err != nil {
+		return fmt.Errorf("failed to generate admin client certificate: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: certSecretName, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"ca.crt":        caCertPEM,
+			"ca.key":        caKeyPEM,
+			"apiserver.crt": apiserverCertPEM,
+			"apiserver.key": apiserverKeyPEM,
+			"etcd.crt":      etcdCertPEM,
+			"etcd.key":      etcdKeyPEM,
+			"admin.crt":     adminCertPEM,
+			"admin.key":     adminKeyPEM,
+		},
+	}
+
+	if _, err := client.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create secret %s: %w", certSecretName, err)
+	}
+
+	return nil
+}
+
+// generateSelfSignedCA creates a self-signed CA certificate and private key, returning both
+// the PEM-encoded forms (for storage) and the parsed forms (for signing further certificates).
+func generateSelfSignedCA() (certPEM, keyPEM []byte, cert *x509.Certificate, key *rsa.PrivateKey, err error) {
+	key, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "karmada-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(certValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return encodeCertPEM(der), encodeKeyPEM(key), cert, key, nil
+}
+
+// generateSignedCert creates a serving certificate for commonName/dnsNames, signed by ca/caKey.
+func generateSignedCert(ca *x509.Certificate, caKey *rsa.PrivateKey, commonName string, dnsNames []string) (certPEM, keyPEM []byte, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertPEM(der), encodeKeyPEM(key), nil
+}
+
+// generateSignedClientCert creates a client authentication certificate for commonName,
+// signed by ca/caKey, with the given organizations (mapped to RBAC/authn groups by the
+// apiserver verifying it).
+func generateSignedClientCert(ca *x509.Certificate, caKey *rsa.PrivateKey, commonName string, organizations []string) (certPEM, keyPEM []byte, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName, Organization: organizations},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertPEM(der), encodeKeyPEM(key), nil
+}
+
+func encodeCertPEM(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func encodeKeyPEM(key *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
@@ -0,0 +1,55 @@
+package karmadainit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+)
+
+// installCRDs applies every *.yaml CustomResourceDefinition manifest found in dir.
+// CRDs that already exist are left untouched so `init` can be run more than once.
+func installCRDs(client apiextensionsclientset.Interface, dir string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read CRDs directory %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
+			continue
+		}
+
+		raw, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read CRD manifest %s: %w", entry.Name(), err)
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(raw, crd); err != nil {
+			return fmt.Errorf("failed to decode CRD manifest %s: %w", entry.Name(), err)
+		}
+
+		_, err = client.ApiextensionsV1().CustomResourceDefinitions().Create(context.TODO(), crd, metav1.CreateOptions{})
+		if err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				klog.V(1).Infof("CRD %s already exists, skipping", crd.Name)
+				continue
+			}
+			return fmt.Errorf("failed to create CRD %s: %w", crd.Name, err)
+		}
+	}
+
+	return nil
+}
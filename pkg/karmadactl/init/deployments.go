@@ -0,0 +1,172 @@
+package karmadainit
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// component describes a single Karmada control-plane Deployment/Service pair.
+type component struct {
+	name     string
+	image    string
+	port     int32
+	replicas int32
+	args     []string
+	// mountCerts, when true, mounts the karmada-cert Secret (see certs.go) read-only at certMountPath.
+	mountCerts bool
+	// mountKubeconfig, when true, mounts the karmada-kubeconfig Secret (see kubeconfig.go)
+	// read-only as a single file at kubeconfigMountPath.
+	mountKubeconfig bool
+}
+
+const (
+	etcdClientPort       = 2379
+	certMountPath        = "/etc/karmada/pki"
+	etcdServiceName      = "karmada-etcd"
+	apiserverServiceName = "karmada-apiserver"
+	apiserverPort        = 5443
+	kubeconfigMountPath  = "/etc/karmada/kubeconfig"
+)
+
+// controlPlaneComponents returns the set of Deployments/Services that make up a Karmada control plane:
+// etcd, the Karmada apiserver, controller-manager, scheduler, and admission webhook.
+func controlPlaneComponents(opts CommandInitOption) []component {
+	image := func(name string) string {
+		return fmt.Sprintf("%s/%s:%s", opts.ImageRegistry, name, opts.KarmadaVersion)
+	}
+	etcdServers := fmt.Sprintf("https://%s:%d", etcdServiceName, etcdClientPort)
+
+	return []component{
+		{name: etcdServiceName, image: fmt.Sprintf("%s/etcd:%s", opts.ImageRegistry, "3.5.9-0"), port: etcdClientPort, replicas: 1,
+			args: []string{
+				"--data-dir=/var/lib/etcd",
+				fmt.Sprintf("--cert-file=%s/etcd.crt", certMountPath),
+				fmt.Sprintf("--key-file=%s/etcd.key", certMountPath),
+				fmt.Sprintf("--trusted-ca-file=%s/ca.crt", certMountPath),
+			},
+			mountCerts: true,
+		},
+		{name: apiserverServiceName, image: image("karmada-apiserver"), port: apiserverPort, replicas: 1,
+			args: []string{
+				fmt.Sprintf("--etcd-servers=%s", etcdServers),
+				fmt.Sprintf("--etcd-cafile=%s/ca.crt", certMountPath),
+				fmt.Sprintf("--tls-cert-file=%s/apiserver.crt", certMountPath),
+				fmt.Sprintf("--tls-private-key-file=%s/apiserver.key", certMountPath),
+			},
+			mountCerts: true,
+		},
+		{name: "karmada-controller-manager", image: image("karmada-controller-manager"), port: 10357, replicas: 1,
+			args:            []string{fmt.Sprintf("--kubeconfig=%s", kubeconfigMountPath)},
+			mountKubeconfig: true,
+		},
+		{name: "karmada-scheduler", image: image("karmada-scheduler"), port: 10351, replicas: 1,
+			args:            []string{fmt.Sprintf("--kubeconfig=%s", kubeconfigMountPath)},
+			mountKubeconfig: true,
+		},
+		{name: "karmada-webhook", image: image("karmada-webhook"), port: 8443, replicas: 1,
+			args:            []string{fmt.Sprintf("--kubeconfig=%s", kubeconfigMountPath)},
+			mountCerts:      true,
+			mountKubeconfig: true,
+		},
+	}
+}
+
+func createNamespace(client kubeclient.Interface, namespace string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+	_, err := client.CoreV1().Namespaces().Create(context.TODO(), ns, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// deployComponent creates the Deployment and Service for a single control-plane component.
+// Existing objects are left in place so `init` can be re-run safely.
+func deployComponent(client kubeclient.Interface, namespace string, c component, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	labels := map[string]string{"app": c.name}
+	replicas := c.replicas
+	container := corev1.Container{
+		Name:  c.name,
+		Image: c.image,
+		Args:  c.args,
+		Ports: []corev1.ContainerPort{{ContainerPort: c.port}},
+	}
+
+	var volumes []corev1.Volume
+	if c.mountCerts {
+		container.VolumeMounts = append(container.VolumeMounts,
+			corev1.VolumeMount{Name: "certs", MountPath: certMountPath, ReadOnly: true},
+		)
+		volumes = append(volumes, corev1.Volume{
+			Name: "certs", VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: certSecretName},
+			},
+		})
+	}
+	if c.mountKubeconfig {
+		container.VolumeMounts = append(container.VolumeMounts,
+			corev1.VolumeMount{Name: "kubeconfig", MountPath: kubeconfigMountPath, SubPath: kubeconfigSecretKey, ReadOnly: true},
+		)
+		volumes = append(volumes, corev1.Volume{
+			Name: "kubeconfig", VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: kubeconfigSecretName},
+			},
+		})
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: c.name, Namespace: namespace, Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: controlPlaneServiceAccount,
+					Containers:         []corev1.Container{container},
+					Volumes:            volumes,
+				},
+			},
+		},
+	}
+
+	if _, err := client.AppsV1().Deployments(namespace).Create(context.TODO(), deployment, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create deployment %s: %w", c.name, err)
+		}
+		klog.V(1).Infof("Deployment %s already exists, skipping", c.name)
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: c.name, Namespace: namespace, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports:    []corev1.ServicePort{{Port: c.port, TargetPort: intstr.FromInt(int(c.port))}},
+		},
+	}
+
+	if _, err := client.CoreV1().Services(namespace).Create(context.TODO(), svc, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create service %s: %w", c.name, err)
+		}
+		klog.V(1).Infof("Service %s already exists, skipping", c.name)
+	}
+
+	return nil
+}
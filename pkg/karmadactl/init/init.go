@@ -0,0 +1,150 @@
+// Package karmadainit implements the `karmadactl init` command, which
+// bootstraps a Karmada control plane on a host cluster.
+package karmadainit
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+)
+
+const defaultKarmadaSystemNamespace = "karmada-system"
+
+var (
+	initLong = `Init bootstraps a Karmada control plane on the host cluster: RBAC, a self-signed CA and serving certificates, CRDs, etcd, karmada-apiserver, karmada-controller-manager, karmada-scheduler and the webhook.`
+
+	initExample = `
+karmadactl init --crds /path/to/crds
+`
+)
+
+// NewCmdInit defines the `init` command that bootstraps a Karmada control plane on the host cluster.
+func NewCmdInit(cmdOut io.Writer, karmadaConfig karmadactl.KarmadaConfig) *cobra.Command {
+	opts := CommandInitOption{}
+
+	cmd := &cobra.Command{
+		Use:     "init",
+		Short:   "Bootstrap a Karmada control plane on the host cluster",
+		Long:    initLong,
+		Example: initExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return RunInit(cmdOut, karmadaConfig, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.AddFlags(flags)
+
+	return cmd
+}
+
+// CommandInitOption holds all command options.
+type CommandInitOption struct {
+	options.GlobalCommandOptions
+
+	// Context is the host cluster's context in kubeconfig to bootstrap Karmada on.
+	Context string
+
+	// CRDs is the path to a directory of Karmada CRD manifests to install.
+	CRDs string
+
+	// KarmadaVersion is the image tag used for the control-plane component images.
+	KarmadaVersion string
+
+	// ImageRegistry is the registry prefix used when pulling control-plane component images.
+	ImageRegistry string
+}
+
+// Complete fills in defaults that depend on other options having been parsed.
+func (i *CommandInitOption) Complete() error {
+	if len(i.ClusterNamespace) == 0 {
+		i.ClusterNamespace = defaultKarmadaSystemNamespace
+	}
+	return nil
+}
+
+// Validate ensures that options are valid.
+func (i *CommandInitOption) Validate() error {
+	if len(i.CRDs) == 0 {
+		return fmt.Errorf("--crds is required")
+	}
+	return nil
+}
+
+// AddFlags adds flags to the specified FlagSet.
+func (i *CommandInitOption) AddFlags(flags *pflag.FlagSet) {
+	i.GlobalCommandOptions.AddFlags(flags)
+
+	flags.StringVar(&i.Context, "context", "",
+		"Context name of the host cluster in kubeconfig.")
+	flags.StringVar(&i.CRDs, "crds", "",
+		"Path to a directory containing the Karmada CRD manifests to install.")
+	flags.StringVar(&i.KarmadaVersion, "karmada-version", "latest",
+		"Image tag used for the Karmada control-plane components.")
+	flags.StringVar(&i.ImageRegistry, "image-registry", "docker.io/karmada",
+		"Registry prefix used when pulling Karmada control-plane images.")
+}
+
+// RunInit is the implementation of the 'init' command.
+func RunInit(cmdOut io.Writer, karmadaConfig karmadactl.KarmadaConfig, opts CommandInitOption) error {
+	klog.V(1).Infof("initializing karmada control plane. namespace: %s", opts.ClusterNamespace)
+
+	restConfig, err := karmadaConfig.GetRestConfig(opts.Context, opts.KubeConfig)
+	if err != nil {
+		klog.Errorf("failed to get host cluster rest config. context: %s, kube-config: %s, error: %v",
+			opts.Context, opts.KubeConfig, err)
+		return err
+	}
+
+	kubeClient := kubeclient.NewForConfigOrDie(restConfig)
+	apiextensionsClient := apiextensionsclientset.NewForConfigOrDie(restConfig)
+
+	if err := createNamespace(kubeClient, opts.ClusterNamespace, opts.DryRun); err != nil {
+		klog.Errorf("Failed to create namespace %s, error: %v", opts.ClusterNamespace, err)
+		return err
+	}
+
+	if err := ensureControlPlaneRBAC(kubeClient, opts.ClusterNamespace, opts.DryRun); err != nil {
+		klog.Errorf("Failed to set up control plane RBAC, error: %v", err)
+		return err
+	}
+
+	if err := ensureCertsSecret(kubeClient, opts.ClusterNamespace, opts.DryRun); err != nil {
+		klog.Errorf("Failed to set up control plane certificates, error: %v", err)
+		return err
+	}
+
+	if err := ensureKubeconfigSecret(kubeClient, opts.ClusterNamespace, opts.DryRun); err != nil {
+		klog.Errorf("Failed to set up control plane kubeconfig, error: %v", err)
+		return err
+	}
+
+	if err := installCRDs(apiextensionsClient, opts.CRDs, opts.DryRun); err != nil {
+		klog.Errorf("Failed to install CRDs from %s, error: %v", opts.CRDs, err)
+		return err
+	}
+
+	for _, c := range controlPlaneComponents(opts) {
+		if err := deployComponent(kubeClient, opts.ClusterNamespace, c, opts.DryRun); err != nil {
+			klog.Errorf("Failed to deploy %s, error: %v", c.name, err)
+			return err
+		}
+	}
+
+	fmt.Fprintf(cmdOut, "Karmada control plane initialized in namespace %q.\n", opts.ClusterNamespace)
+	return nil
+}
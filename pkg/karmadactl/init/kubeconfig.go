@@ -0,0 +1,92 @@
+package karmadainit
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog/v2"
+)
+
+// kubeconfigSecretName holds the kubeconfig the control-plane components use
+// to talk to the karmada-apiserver as themselves.
+const kubeconfigSecretName = "karmada-kubeconfig"
+
+// kubeconfigSecretKey is the Secret data key the kubeconfig is stored under,
+// and the file name it's mounted as (see deployComponent in deployments.go).
+const kubeconfigSecretKey = "kubeconfig"
+
+// ensureKubeconfigSecret makes sure the karmada-kubeconfig Secret exists in
+// namespace, building a kubeconfig for the karmada-apiserver out of the
+// karmada-cert Secret's CA and admin client certificate. It must run after
+// ensureCertsSecret. An existing secret is left untouched so re-running
+// `init` doesn't invalidate kubeconfigs already in use by running components.
+func ensureKubeconfigSecret(client kubeclient.Interface, namespace string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	_, err := client.CoreV1().Secrets(namespace).Get(context.TODO(), kubeconfigSecretName, metav1.GetOptions{})
+	if err == nil {
+		klog.V(1).Infof("Secret %s already exists, skipping kubeconfig generation", kubeconfigSecretName)
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get secret %s: %w", kubeconfigSecretName, err)
+	}
+
+	certsSecret, err := client.CoreV1().Secrets(namespace).Get(context.TODO(), certSecretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s: %w", certSecretName, err)
+	}
+
+	kubeconfig, err := buildKubeconfig(certsSecret.Data["ca.crt"], certsSecret.Data["admin.crt"], certsSecret.Data["admin.key"])
+	if err != nil {
+		return fmt.Errorf("failed to build kubeconfig: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: kubeconfigSecretName, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			kubeconfigSecretKey: kubeconfig,
+		},
+	}
+
+	if _, err := client.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create secret %s: %w", kubeconfigSecretName, err)
+	}
+
+	return nil
+}
+
+// buildKubeconfig renders a kubeconfig pointing at the in-cluster
+// karmada-apiserver Service, authenticating with the given client certificate.
+func buildKubeconfig(caCertPEM, clientCertPEM, clientKeyPEM []byte) ([]byte, error) {
+	const contextName = "karmada"
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[contextName] = &clientcmdapi.Cluster{
+		Server:                   fmt.Sprintf("https://%s:%d", apiserverServiceName, apiserverPort),
+		CertificateAuthorityData: caCertPEM,
+	}
+	config.AuthInfos[contextName] = &clientcmdapi.AuthInfo{
+		ClientCertificateData: clientCertPEM,
+		ClientKeyData:         clientKeyPEM,
+	}
+	config.Contexts[contextName] = &clientcmdapi.Context{
+		Cluster:  contextName,
+		AuthInfo: contextName,
+	}
+	config.CurrentContext = contextName
+
+	return clientcmd.Write(*config)
+}
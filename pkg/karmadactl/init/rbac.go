@@ -0,0 +1,92 @@
+package karmadainit
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// controlPlaneServiceAccount is shared by every control-plane component: they
+// all need the same broad access to the host cluster (to manage execution
+// spaces, Works, and their own Secrets/Deployments) at bootstrap time.
+const controlPlaneServiceAccount = "karmada-controlplane"
+
+const controlPlaneClusterRole = "karmada-controlplane"
+
+// ensureControlPlaneRBAC creates the ServiceAccount, ClusterRole and
+// ClusterRoleBinding the control-plane components run as. Existing objects
+// are left in place so `init` can be re-run safely.
+func ensureControlPlaneRBAC(client kubeclient.Interface, namespace string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: controlPlaneServiceAccount, Namespace: namespace},
+	}
+	if _, err := client.CoreV1().ServiceAccounts(namespace).Create(context.TODO(), sa, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create service account %s: %w", controlPlaneServiceAccount, err)
+		}
+		klog.V(1).Infof("ServiceAccount %s already exists, skipping", controlPlaneServiceAccount)
+	}
+
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: controlPlaneClusterRole},
+		Rules: []rbacv1.PolicyRule{
+			{
+				// Karmada's own APIs: clusters, bindings, works, policies, ... RBAC apiGroups
+				// don't support subdomain wildcards (only an exact group or the single
+				// token "*"), so every Karmada group has to be listed explicitly.
+				APIGroups: []string{
+					"cluster.karmada.io",
+					"work.karmada.io",
+					"policy.karmada.io",
+					"config.karmada.io",
+					"networking.karmada.io",
+				},
+				Resources: []string{"*"},
+				Verbs:     []string{"*"},
+			},
+			{
+				// Execution-space namespaces, Secrets for member kubeconfigs, and
+				// the control plane's own Deployments.
+				APIGroups: []string{"", "apps"},
+				Resources: []string{"namespaces", "secrets", "serviceaccounts", "deployments", "services"},
+				Verbs:     []string{"*"},
+			},
+		},
+	}
+	if _, err := client.RbacV1().ClusterRoles().Create(context.TODO(), clusterRole, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create cluster role %s: %w", controlPlaneClusterRole, err)
+		}
+		klog.V(1).Infof("ClusterRole %s already exists, skipping", controlPlaneClusterRole)
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: controlPlaneClusterRole},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     controlPlaneClusterRole,
+		},
+		Subjects: []rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Name: controlPlaneServiceAccount, Namespace: namespace},
+		},
+	}
+	if _, err := client.RbacV1().ClusterRoleBindings().Create(context.TODO(), binding, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create cluster role binding %s: %w", controlPlaneClusterRole, err)
+		}
+		klog.V(1).Infof("ClusterRoleBinding %s already exists, skipping", controlPlaneClusterRole)
+	}
+
+	return nil
+}
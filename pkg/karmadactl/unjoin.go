@@ -3,38 +3,75 @@ package karmadactl
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"os"
+	"sync"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	kcutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/names"
 )
 
+const (
+	// unjoinDrainTaintKey is set on the Cluster object being drained so the
+	// scheduler stops placing new replicas there and the eviction controller
+	// starts migrating the existing ones off.
+	unjoinDrainTaintKey = "unjoin.karmada.io/draining"
+
+	// readyConditionType is the Cluster status condition used to derive its delete status.
+	readyConditionType = "Ready"
+)
+
+// allowedDeleteStatus is the set of Cluster delete-statuses, derived from the
+// Ready condition's status (see clusterDeleteStatus), that are safe to unjoin
+// without --force. "Unknown" (no Ready condition reported yet, e.g. the
+// cluster is still being joined) is deliberately excluded: some other
+// controller is still actively reconciling the cluster, so unjoin refuses
+// unless the caller passes --force.
+var allowedDeleteStatus = sets.NewString(
+	"Ready",
+	"NotReady",
+)
+
 var (
-	unjoinLong = `Unjoin removes the registration of a member cluster from control plane.`
+	unjoinLong = `Unjoin removes the registration of one or more member clusters from control plane.`
 
 	unjoinExample = `
 karmadactl unjoin CLUSTER_NAME --member-cluster-kubeconfig=<KUBECONFIG>
+
+karmadactl unjoin cluster1 cluster2 cluster3 --parallelism=2
+
+karmadactl unjoin --selector=env=staging
+
+karmadactl unjoin --filename=clusters.yaml
 `
 )
 
-// NewCmdUnjoin defines the `unjoin` command that removes registration of a member cluster from control plane.
+// NewCmdUnjoin defines the `unjoin` command that removes registration of one or more member clusters from control plane.
 func NewCmdUnjoin(cmdOut io.Writer, karmadaConfig KarmadaConfig) *cobra.Command {
 	opts := CommandUnjoinOption{}
 
 	cmd := &cobra.Command{
-		Use:     "unjoin CLUSTER_NAME --member-cluster-kubeconfig=<KUBECONFIG>",
-		Short:   "Remove the registration of a member cluster from control plane",
+		Use:     "unjoin (CLUSTER_NAME... | --selector SELECTOR | --filename FILENAME) --member-cluster-kubeconfig=<KUBECONFIG>",
+		Short:   "Remove the registration of one or more member clusters from control plane",
 		Long:    unjoinLong,
 		Example: unjoinExample,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -62,6 +99,9 @@ func NewCmdUnjoin(cmdOut io.Writer, karmadaConfig KarmadaConfig) *cobra.Command
 type CommandUnjoinOption struct {
 	options.GlobalCommandOptions
 
+	// Context is the control plane's context in kubeconfig used to reach it.
+	Context string
+
 	// ClusterName is the member cluster's name that we are going to join with.
 	ClusterName string
 
@@ -71,62 +111,416 @@ type CommandUnjoinOption struct {
 	// ClusterKubeConfig is the member cluster's kubeconfig path.
 	ClusterKubeConfig string
 
+	// Selector is a label selector evaluated against Cluster objects on the
+	// control plane to resolve the set of clusters to unjoin. Mutually
+	// exclusive with positional cluster names and Filename.
+	Selector string
+
+	// Filename is a path to a YAML/JSON manifest listing clusters to unjoin,
+	// each with its own optional kubeconfig/context. Mutually exclusive with
+	// positional cluster names and Selector.
+	Filename string
+
+	// Parallelism is the number of clusters unjoined concurrently.
+	Parallelism int
+
 	forceDeletion bool
+
+	// drain, when set, taints the member cluster and waits for its workloads
+	// to be rescheduled onto remaining clusters before tearing anything down.
+	drain bool
+	// drainTimeout bounds how long RunUnjoin waits for the drain to finish
+	// before moving on and deleting the execution space/cluster object anyway.
+	drainTimeout time.Duration
+	// gracePeriod is how long, in seconds, to wait for in-flight Work objects
+	// to migrate naturally during a drain before forcing their deletion. A
+	// negative value disables forcing and waits up to drainTimeout instead.
+	gracePeriod int32
+
+	// targetNames holds the positional cluster name arguments, set by Complete.
+	targetNames []string
+
+	// waitOutput selects how progress is reported while waiting for resources to be deleted.
+	waitOutput string
 }
 
 // Complete ensures that options are valid and marshals them if necessary.
 func (j *CommandUnjoinOption) Complete(args []string) error {
-	// Get member cluster name from the command args.
-	if len(args) == 0 {
-		return errors.New("member cluster name is required")
+	modes := 0
+	if len(args) > 0 {
+		modes++
+	}
+	if len(j.Selector) > 0 {
+		modes++
+	}
+	if len(j.Filename) > 0 {
+		modes++
+	}
+	if modes == 0 {
+		return errors.New("at least one cluster name, --selector, or --filename is required")
+	}
+	if modes > 1 {
+		return errors.New("cluster names, --selector, and --filename are mutually exclusive")
+	}
+
+	if _, err := kcutil.ParseWaitOutputMode(j.waitOutput); err != nil {
+		return err
+	}
+
+	j.targetNames = args
+
+	// Before '--context' existed, a single-cluster invocation used
+	// '--member-cluster-context' to select the control plane's context too
+	// (both the control plane and the member cluster were commonly reached
+	// through the same kubeconfig context). Preserve that behavior for
+	// existing callers who still only pass '--member-cluster-context'.
+	if len(args) == 1 && len(j.Context) == 0 && len(j.ClusterContext) > 0 {
+		j.Context = j.ClusterContext
+	}
+
+	// If '--member-cluster-context' not specified and a single cluster name was given,
+	// take the cluster name as the context.
+	if len(args) == 1 && len(j.ClusterContext) == 0 {
+		j.ClusterContext = args[0]
+	}
+
+	return nil
+}
+
+// SetForce sets forceDeletion for callers outside this package (e.g. `destroy
+// --force`) that need to bypass Validate's refusal without going through the
+// '--force' flag themselves.
+func (j *CommandUnjoinOption) SetForce(force bool) {
+	j.forceDeletion = force
+}
+
+// Validate checks that it is safe to unjoin the target cluster. Unless --force
+// is set, it refuses a Cluster object that reports no Ready condition yet
+// (e.g. one still being joined), to avoid racing with the join controller
+// while it's still provisioning the cluster. Any cluster reporting a Ready
+// condition, True or False, is allowed; other in-progress operations, such as
+// an upgrade, are not detected.
+func (j *CommandUnjoinOption) Validate(controlPlaneKarmadaClient karmadaclientset.Interface) error {
+	if j.forceDeletion {
+		return nil
+	}
+
+	cluster, err := controlPlaneKarmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), j.ClusterName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
 	}
-	j.ClusterName = args[0]
 
-	// If '--member-cluster-context' not specified, take the cluster name as the context.
-	if len(j.ClusterContext) == 0 {
-		j.ClusterContext = j.ClusterName
+	status := clusterDeleteStatus(cluster.Status.Conditions)
+	if !allowedDeleteStatus.Has(status) {
+		return fmt.Errorf("cluster %q is in state %q which disallows unjoin, pass --force to override", j.ClusterName, status)
 	}
 
 	return nil
 }
 
+// clusterDeleteStatus derives a coarse state name from the Cluster's Ready
+// condition status: "Ready" when the condition is True, "NotReady" when it's
+// False. The condition's Reason (e.g. "ClusterReady", "ClusterNotReady") is
+// informational and not a stable enum to switch on, so it's not used here.
+// Clusters with no Ready condition yet (still being joined) report "Unknown",
+// which is not in allowedDeleteStatus.
+func clusterDeleteStatus(conditions []metav1.Condition) string {
+	condition := meta.FindStatusCondition(conditions, readyConditionType)
+	if condition == nil {
+		return "Unknown"
+	}
+	if condition.Status == metav1.ConditionTrue {
+		return "Ready"
+	}
+	return "NotReady"
+}
+
 // AddFlags adds flags to the specified FlagSet.
 func (j *CommandUnjoinOption) AddFlags(flags *pflag.FlagSet) {
 	j.GlobalCommandOptions.AddFlags(flags)
 
+	flags.StringVar(&j.Context, "context", "",
+		"Context name of the control plane in kubeconfig. If unset and a single cluster name is given, falls back to '--member-cluster-context' for backward compatibility.")
 	flags.StringVar(&j.ClusterContext, "member-cluster-context", "",
 		"Context name of member cluster in kubeconfig. Only works when there are multiple contexts in the kubeconfig.")
 	flags.StringVar(&j.ClusterKubeConfig, "member-cluster-kubeconfig", "",
 		"Path of the member cluster's kubeconfig.")
+	flags.StringVar(&j.Selector, "selector", "",
+		"A label selector evaluated against Cluster objects on the control plane to select the clusters to unjoin. Mutually exclusive with CLUSTER_NAME and --filename.")
+	flags.StringVar(&j.Filename, "filename", "",
+		"Path to a YAML/JSON manifest listing clusters to unjoin, each with an optional per-cluster kubeconfig/context. Mutually exclusive with CLUSTER_NAME and --selector.")
+	flags.IntVar(&j.Parallelism, "parallelism", 1,
+		"Number of clusters to unjoin concurrently.")
 	flags.BoolVar(&j.forceDeletion, "force", false,
 		"Delete cluster and secret resources even if resources in the member cluster targeted for unjoin are not removed successfully.")
+	flags.BoolVar(&j.drain, "drain", false,
+		"Taint the cluster and wait for its workloads to be rescheduled onto remaining clusters before unjoining it, similar to 'kubectl drain'.")
+	flags.DurationVar(&j.drainTimeout, "drain-timeout", 20*time.Minute,
+		"Length of time to wait for the drain to complete before proceeding with the unjoin anyway, e.g. 20m, 1h. Only applies when '--drain' is set.")
+	flags.Int32Var(&j.gracePeriod, "grace-period", -1,
+		"Period of time, in seconds, given to Work objects to migrate naturally during the drain before their deletion is forced. If negative, Works are never forced and the drain waits up to '--drain-timeout' instead. Only applies when '--drain' is set.")
+	flags.StringVar(&j.waitOutput, "wait-output", string(kcutil.WaitOutputProgress),
+		"Output mode for progress while waiting on deletions: silent, spinner, progress, or json.")
+}
+
+// unjoinManifestCluster describes a single cluster entry accepted via --filename.
+type unjoinManifestCluster struct {
+	Name       string `json:"name"`
+	Context    string `json:"context,omitempty"`
+	KubeConfig string `json:"kubeconfig,omitempty"`
+}
+
+// unjoinManifest is the top-level document accepted via --filename.
+type unjoinManifest struct {
+	Clusters []unjoinManifestCluster `json:"clusters"`
+}
+
+// ResolveClusters expands the command's targeting flags (positional cluster
+// names, --selector, or --filename) into one CommandUnjoinOption per cluster
+// to unjoin, ready to hand to RunUnjoin. A CommandUnjoinOption built with
+// ClusterName already set (e.g. by another command embedding unjoin) is
+// passed through unchanged.
+func (j *CommandUnjoinOption) ResolveClusters(controlPlaneKarmadaClient karmadaclientset.Interface) ([]CommandUnjoinOption, error) {
+	switch {
+	case len(j.ClusterName) > 0:
+		return []CommandUnjoinOption{*j}, nil
+	case len(j.Filename) > 0:
+		return j.resolveFromManifest()
+	case len(j.Selector) > 0:
+		return j.resolveFromSelector(controlPlaneKarmadaClient)
+	case len(j.targetNames) > 0:
+		return j.resolveFromNames(), nil
+	default:
+		return nil, errors.New("at least one cluster name, --selector, or --filename is required")
+	}
+}
+
+// resolveFromNames builds one CommandUnjoinOption per positional cluster name argument.
+func (j *CommandUnjoinOption) resolveFromNames() []CommandUnjoinOption {
+	opts := make([]CommandUnjoinOption, 0, len(j.targetNames))
+	for _, name := range j.targetNames {
+		clusterOpts := *j
+		clusterOpts.ClusterName = name
+		clusterOpts.ClusterContext = name
+		if len(j.targetNames) == 1 && len(j.ClusterContext) > 0 {
+			clusterOpts.ClusterContext = j.ClusterContext
+		}
+		opts = append(opts, clusterOpts)
+	}
+	return opts
 }
 
-// RunUnjoin is the implementation of the 'unjoin' command.
+// resolveFromSelector lists Cluster objects on the control plane matching Selector.
+func (j *CommandUnjoinOption) resolveFromSelector(controlPlaneKarmadaClient karmadaclientset.Interface) ([]CommandUnjoinOption, error) {
+	clusters, err := controlPlaneKarmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{LabelSelector: j.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters matching selector %q: %w", j.Selector, err)
+	}
+
+	opts := make([]CommandUnjoinOption, 0, len(clusters.Items))
+	for i := range clusters.Items {
+		clusterOpts := *j
+		clusterOpts.ClusterName = clusters.Items[i].Name
+		clusterOpts.ClusterContext = clusters.Items[i].Name
+		opts = append(opts, clusterOpts)
+	}
+	return opts, nil
+}
+
+// resolveFromManifest reads Filename and builds one CommandUnjoinOption per listed cluster.
+func (j *CommandUnjoinOption) resolveFromManifest() ([]CommandUnjoinOption, error) {
+	raw, err := os.ReadFile(j.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest %s: %w", j.Filename, err)
+	}
+
+	manifest := unjoinManifest{}
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest %s: %w", j.Filename, err)
+	}
+
+	opts := make([]CommandUnjoinOption, 0, len(manifest.Clusters))
+	for _, c := range manifest.Clusters {
+		if len(c.Name) == 0 {
+			return nil, fmt.Errorf("manifest %s has a cluster entry with no name", j.Filename)
+		}
+
+		clusterOpts := *j
+		clusterOpts.ClusterName = c.Name
+		clusterOpts.ClusterContext = c.Name
+		if len(c.Context) > 0 {
+			clusterOpts.ClusterContext = c.Context
+		}
+		if len(c.KubeConfig) > 0 {
+			clusterOpts.ClusterKubeConfig = c.KubeConfig
+		}
+		opts = append(opts, clusterOpts)
+	}
+	return opts, nil
+}
+
+// RunUnjoin is the implementation of the 'unjoin' command. It resolves opts
+// into the set of clusters to unjoin (positional names, --selector, or
+// --filename) and unjoins them, up to opts.Parallelism at a time, returning
+// an aggregated error if any of them failed.
 // TODO(RainbowMango): consider to remove the 'KarmadaConfig'.
 func RunUnjoin(cmdOut io.Writer, karmadaConfig KarmadaConfig, opts CommandUnjoinOption) error {
-	klog.V(1).Infof("unjoining member cluster. member cluster name: %s", opts.ClusterName)
-	klog.V(1).Infof("unjoining member cluster. cluster namespace: %s", opts.ClusterNamespace)
+	klog.V(1).Infof("unjoining member cluster(s). cluster namespace: %s", opts.ClusterNamespace)
 
 	// Get control plane kube-apiserver client
-	controlPlaneRestConfig, err := karmadaConfig.GetRestConfig(opts.ClusterContext, opts.KubeConfig)
+	controlPlaneRestConfig, err := karmadaConfig.GetRestConfig(opts.Context, opts.KubeConfig)
 	if err != nil {
 		klog.Errorf("failed to get control plane rest config. context: %s, kube-config: %s, error: %v",
-			opts.ClusterContext, opts.KubeConfig, err)
+			opts.Context, opts.KubeConfig, err)
 		return err
 	}
 
 	controlPlaneKarmadaClient := karmadaclientset.NewForConfigOrDie(controlPlaneRestConfig)
 	controlPlaneKubeClient := kubeclient.NewForConfigOrDie(controlPlaneRestConfig)
 
-	// todo: taint member cluster object instead of deleting execution space.
-	//  Once the member cluster is tainted, eviction controller will delete all propagationwork in the execution space of the member cluster.
+	clusterOpts, err := opts.ResolveClusters(controlPlaneKarmadaClient)
+	if err != nil {
+		return err
+	}
+
+	reporter := kcutil.NewProgressReporter(kcutil.WaitOutputMode(opts.waitOutput), cmdOut)
+	report := runUnjoinBatch(karmadaConfig, controlPlaneKarmadaClient, controlPlaneKubeClient, clusterOpts, opts.Parallelism, reporter)
+	report.Fprint(cmdOut)
+	return report.Err()
+}
+
+// errSkipped wraps the reason a cluster was left alone by Validate (and --force
+// wasn't passed to override it), so runUnjoinBatch can bucket it as skipped
+// rather than failed.
+type errSkipped struct {
+	reason error
+}
+
+func (e *errSkipped) Error() string { return e.reason.Error() }
+func (e *errSkipped) Unwrap() error { return e.reason }
+
+// UnjoinResult captures the outcome of unjoining a single cluster.
+type UnjoinResult struct {
+	// ClusterName is the member cluster that was unjoined.
+	ClusterName string
+	// Skipped is true when the cluster was left alone because Validate refused it
+	// (e.g. it's mid some other operation) and --force was not passed; this is not
+	// a failure, the cluster simply wasn't touched.
+	Skipped bool
+	// Err is the error returned while unjoining ClusterName (or the reason it was
+	// skipped), or nil on success.
+	Err error
+}
+
+// UnjoinReport aggregates the per-cluster results of a batch unjoin.
+type UnjoinReport struct {
+	Results []UnjoinResult
+}
+
+// Err returns an aggregate of every failed (not skipped) cluster's error, or nil if every
+// cluster was either unjoined successfully or skipped.
+func (r *UnjoinReport) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil && !result.Skipped {
+			errs = append(errs, fmt.Errorf("cluster %q: %w", result.ClusterName, result.Err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// Fprint writes a per-cluster outcome and a succeeded/failed/skipped summary to w.
+func (r *UnjoinReport) Fprint(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CLUSTER\tRESULT")
+
+	var succeeded, failed, skipped int
+	for _, result := range r.Results {
+		switch {
+		case result.Skipped:
+			skipped++
+			fmt.Fprintf(tw, "%s\tskipped: %v\n", result.ClusterName, result.Err)
+		case result.Err != nil:
+			failed++
+			fmt.Fprintf(tw, "%s\tfailed: %v\n", result.ClusterName, result.Err)
+		default:
+			succeeded++
+			fmt.Fprintf(tw, "%s\tunjoined\n", result.ClusterName)
+		}
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "unjoin summary: %d succeeded, %d failed, %d skipped, %d total\n", succeeded, failed, skipped, len(r.Results))
+}
+
+// runUnjoinBatch unjoins every cluster in clusterOpts, up to parallelism at a time,
+// collecting each one's outcome rather than stopping at the first error. reporter is
+// shared across all clusters; stage names are prefixed per-cluster so their progress
+// doesn't get mixed up.
+func runUnjoinBatch(karmadaConfig KarmadaConfig, controlPlaneKarmadaClient karmadaclientset.Interface,
+	controlPlaneKubeClient kubeclient.Interface, clusterOpts []CommandUnjoinOption, parallelism int, reporter kcutil.ProgressReporter) *UnjoinReport {
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	sem := make(chan struct{}, parallelism)
+	results := make([]UnjoinResult, len(clusterOpts))
+
+	var wg sync.WaitGroup
+	for i := range clusterOpts {
+		i := i
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := unjoinCluster(karmadaConfig, controlPlaneKarmadaClient, controlPlaneKubeClient, clusterOpts[i], reporter)
+			var skipErr *errSkipped
+			result := UnjoinResult{ClusterName: clusterOpts[i].ClusterName, Err: err}
+			if errors.As(err, &skipErr) {
+				result.Skipped = true
+				result.Err = skipErr.reason
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	return &UnjoinReport{Results: results}
+}
+
+// unjoinCluster unjoins a single member cluster: it drains the cluster when
+// --drain is set, deletes the execution space on the control plane, optionally cleans up the RBAC/service
+// account/namespace left behind in the member cluster, and finally deletes
+// the Cluster object itself. Every stage reports its progress through reporter.
+func unjoinCluster(karmadaConfig KarmadaConfig, controlPlaneKarmadaClient karmadaclientset.Interface,
+	controlPlaneKubeClient kubeclient.Interface, opts CommandUnjoinOption, reporter kcutil.ProgressReporter) error {
+	klog.V(1).Infof("unjoining member cluster. member cluster name: %s", opts.ClusterName)
+
+	if err := opts.Validate(controlPlaneKarmadaClient); err != nil {
+		klog.Errorf("Refusing to unjoin cluster %s: %v", opts.ClusterName, err)
+		return &errSkipped{reason: err}
+	}
+
 	executionSpaceName, err := names.GenerateExecutionSpaceName(opts.ClusterName)
 	if err != nil {
 		return err
 	}
 
-	err = deleteExecutionSpace(controlPlaneKubeClient, executionSpaceName, opts.DryRun)
+	// Taint the member cluster object so the scheduler and eviction controller
+	// reschedule its workloads elsewhere before we tear anything down.
+	if opts.drain {
+		if err := drainCluster(controlPlaneKarmadaClient, opts.ClusterName, executionSpaceName, opts.gracePeriod, opts.drainTimeout, opts.DryRun, reporter); err != nil {
+			klog.Errorf("Failed to drain member cluster %s, error: %v", opts.ClusterName, err)
+			return err
+		}
+	}
+
+	err = deleteExecutionSpace(controlPlaneKubeClient, executionSpaceName, opts.DryRun, reporter, fmt.Sprintf("%s: execution-space deletion", opts.ClusterName))
 	if err != nil {
 		klog.Errorf("Failed to delete execution space %s, error: %v", executionSpaceName, err)
 		return err
@@ -146,21 +540,27 @@ func RunUnjoin(cmdOut io.Writer, karmadaConfig KarmadaConfig, opts CommandUnjoin
 		klog.V(1).Infof("unjoining member cluster config. endpoint: %s", clusterConfig.Host)
 
 		// delete RBAC resource from unjoining member cluster
-		err = deleteRBACResources(clusterKubeClient, opts.ClusterName, opts.forceDeletion, opts.DryRun)
+		err = reportStage(reporter, opts.ClusterName, "RBAC deletion", func() error {
+			return deleteRBACResources(clusterKubeClient, opts.ClusterName, opts.forceDeletion, opts.DryRun)
+		})
 		if err != nil {
 			klog.Errorf("Failed to delete RBAC resource in unjoining member cluster %q: %v", opts.ClusterName, err)
 			return err
 		}
 
 		// delete service account from unjoining member cluster
-		err = deleteServiceAccount(clusterKubeClient, opts.ClusterNamespace, opts.ClusterName, opts.forceDeletion, opts.DryRun)
+		err = reportStage(reporter, opts.ClusterName, "SA deletion", func() error {
+			return deleteServiceAccount(clusterKubeClient, opts.ClusterNamespace, opts.ClusterName, opts.forceDeletion, opts.DryRun)
+		})
 		if err != nil {
 			klog.Errorf("Failed to delete service account in unjoining member cluster %q: %v", opts.ClusterName, err)
 			return err
 		}
 
 		// delete namespace from unjoining member cluster
-		err = deleteNamespaceFromUnjoinCluster(clusterKubeClient, opts.ClusterNamespace, opts.ClusterName, opts.forceDeletion, opts.DryRun)
+		err = reportStage(reporter, opts.ClusterName, "namespace deletion", func() error {
+			return deleteNamespaceFromUnjoinCluster(clusterKubeClient, opts.ClusterNamespace, opts.ClusterName, opts.forceDeletion, opts.DryRun)
+		})
 		if err != nil {
 			klog.Errorf("Failed to delete namespace in unjoining member cluster %q: %v", opts.ClusterName, err)
 			return err
@@ -168,7 +568,7 @@ func RunUnjoin(cmdOut io.Writer, karmadaConfig KarmadaConfig, opts CommandUnjoin
 	}
 
 	// delete the member cluster object in host cluster that associates the unjoining member cluster
-	err = deleteClusterObject(controlPlaneKarmadaClient, opts.ClusterName, opts.DryRun)
+	err = deleteClusterObject(controlPlaneKarmadaClient, opts.ClusterName, opts.DryRun, reporter, fmt.Sprintf("%s: cluster object deletion", opts.ClusterName))
 	if err != nil {
 		klog.Errorf("Failed to delete member cluster object. cluster name: %s, error: %v", opts.ClusterName, err)
 		return err
@@ -177,6 +577,112 @@ func RunUnjoin(cmdOut io.Writer, karmadaConfig KarmadaConfig, opts CommandUnjoin
 	return nil
 }
 
+// reportStage runs fn, reporting it to reporter as a single-step stage (no polling) so
+// it shows up alongside the polled stages in the same progress stream.
+func reportStage(reporter kcutil.ProgressReporter, clusterName, stage string, fn func() error) error {
+	label := fmt.Sprintf("%s: %s", clusterName, stage)
+	start := time.Now()
+	reporter.Report(kcutil.ProgressEvent{Stage: label, Remaining: 1})
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	reporter.Report(kcutil.ProgressEvent{Stage: label, Remaining: 0, Elapsed: time.Since(start).Round(time.Second), Done: true})
+	return nil
+}
+
+// drainCluster taints the member cluster so the scheduler stops placing new
+// replicas on it and the eviction controller starts migrating the
+// ResourceBinding/ClusterResourceBinding replicas already placed there onto
+// remaining clusters. It reports progress through reporter until every Work
+// in the cluster's execution space has been migrated away or drainTimeout
+// expires, whichever comes first; a timeout is logged but not treated as a
+// fatal error, so the unjoin proceeds anyway.
+//
+// If gracePeriod is non-negative, any Work objects still present once
+// gracePeriod has elapsed are deleted directly rather than waited on for the
+// rest of drainTimeout, forcing their eviction instead of trusting the
+// eviction controller to finish migrating them in time.
+func drainCluster(controlPlaneKarmadaClient karmadaclientset.Interface, clusterName, executionSpaceName string, gracePeriod int32, drainTimeout time.Duration, dryRun bool, reporter kcutil.ProgressReporter) error {
+	if dryRun {
+		return nil
+	}
+
+	klog.V(1).Infof("Draining member cluster %s, grace period: %ds, timeout: %s", clusterName, gracePeriod, drainTimeout)
+
+	if err := taintClusterForDrain(controlPlaneKarmadaClient, clusterName); err != nil {
+		return fmt.Errorf("failed to taint member cluster %s for draining: %w", clusterName, err)
+	}
+
+	start := time.Now()
+	stage := fmt.Sprintf("%s: drain", clusterName)
+	err := kcutil.PollUntilComplete(reporter, stage, 2*time.Second, drainTimeout, func() (int, error) {
+		works, err := controlPlaneKarmadaClient.WorkV1alpha1().Works(executionSpaceName).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+
+		if gracePeriod >= 0 && time.Since(start) > time.Duration(gracePeriod)*time.Second {
+			klog.Warningf("Grace period elapsed for member cluster %s with %d Work(s) still pending, forcing their deletion", clusterName, len(works.Items))
+			for i := range works.Items {
+				err := controlPlaneKarmadaClient.WorkV1alpha1().Works(executionSpaceName).Delete(context.TODO(), works.Items[i].Name, metav1.DeleteOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return len(works.Items), err
+				}
+			}
+			return 0, nil
+		}
+
+		return len(works.Items), nil
+	})
+	if err != nil {
+		if err == wait.ErrWaitTimeout {
+			klog.Warningf("Timed out waiting for member cluster %s to drain after %s, proceeding with unjoin anyway", clusterName, drainTimeout)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// taintClusterForDrain adds a NoSchedule/NoExecute taint to the Cluster
+// object so the scheduler excludes it from future placements and the
+// eviction controller reschedules its existing replicas.
+func taintClusterForDrain(controlPlaneKarmadaClient karmadaclientset.Interface, clusterName string) error {
+	cluster, err := controlPlaneKarmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	drainTaints := []corev1.Taint{
+		{Key: unjoinDrainTaintKey, Effect: corev1.TaintEffectNoSchedule, TimeAdded: timePtr(metav1.Now())},
+		{Key: unjoinDrainTaintKey, Effect: corev1.TaintEffectNoExecute, TimeAdded: timePtr(metav1.Now())},
+	}
+	for _, taint := range drainTaints {
+		if !clusterHasTaint(cluster.Spec.Taints, taint) {
+			cluster.Spec.Taints = append(cluster.Spec.Taints, taint)
+		}
+	}
+
+	_, err = controlPlaneKarmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), cluster, metav1.UpdateOptions{})
+	return err
+}
+
+func clusterHasTaint(taints []corev1.Taint, taint corev1.Taint) bool {
+	for _, t := range taints {
+		if t.Key == taint.Key && t.Effect == taint.Effect {
+			return true
+		}
+	}
+	return false
+}
+
+func timePtr(t metav1.Time) *metav1.Time {
+	return &t
+}
+
 // deleteRBACResources deletes the cluster role, cluster rolebindings from the unjoining member cluster.
 func deleteRBACResources(clusterKubeClient kubeclient.Interface, unjoiningClusterName string, forceDeletion, dryRun bool) error {
 	if dryRun {
@@ -241,7 +747,9 @@ func deleteNamespaceFromUnjoinCluster(clusterKubeClient kubeclient.Interface, na
 	return nil
 }
 
-func deleteExecutionSpace(hostClient kubeclient.Interface, namespace string, dryRun bool) error {
+// deleteExecutionSpace deletes the execution space namespace and waits for it to be
+// gone, reporting progress through reporter under stage.
+func deleteExecutionSpace(hostClient kubeclient.Interface, namespace string, dryRun bool, reporter kcutil.ProgressReporter, stage string) error {
 	if dryRun {
 		return nil
 	}
@@ -252,17 +760,16 @@ func deleteExecutionSpace(hostClient kubeclient.Interface, namespace string, dry
 	}
 
 	// make sure the execution space has been deleted
-	err = wait.Poll(1*time.Second, 30*time.Second, func() (done bool, err error) {
+	err = kcutil.PollUntilComplete(reporter, stage, 1*time.Second, 30*time.Second, func() (int, error) {
 		exist, err := util.IsNamespaceExist(hostClient, namespace)
 		if err != nil {
 			klog.Errorf("Failed to get execution space %s. err: %v", namespace, err)
-			return false, err
+			return 0, err
 		}
-		if !exist {
-			return true, nil
+		if exist {
+			return 1, nil
 		}
-		klog.Infof("Waiting for the execution space %s to be deleted", namespace)
-		return false, nil
+		return 0, nil
 	})
 	if err != nil {
 		klog.Errorf("Failed to delete execution space %s, error: %v", namespace, err)
@@ -272,8 +779,10 @@ func deleteExecutionSpace(hostClient kubeclient.Interface, namespace string, dry
 	return nil
 }
 
-// deleteClusterObject delete the member cluster object in host cluster that associates the unjoining member cluster
-func deleteClusterObject(controlPlaneKarmadaClient *karmadaclientset.Clientset, clusterName string, dryRun bool) error {
+// deleteClusterObject deletes the member cluster object in host cluster that associates the
+// unjoining member cluster, and waits for it to be gone, reporting progress through reporter
+// under stage.
+func deleteClusterObject(controlPlaneKarmadaClient karmadaclientset.Interface, clusterName string, dryRun bool, reporter kcutil.ProgressReporter, stage string) error {
 	if dryRun {
 		return nil
 	}
@@ -288,17 +797,16 @@ func deleteClusterObject(controlPlaneKarmadaClient *karmadaclientset.Clientset,
 	}
 
 	// make sure the given member cluster object has been deleted
-	err = wait.Poll(1*time.Second, 30*time.Second, func() (done bool, err error) {
+	err = kcutil.PollUntilComplete(reporter, stage, 1*time.Second, 30*time.Second, func() (int, error) {
 		_, err = controlPlaneKarmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
 		if apierrors.IsNotFound(err) {
-			return true, nil
+			return 0, nil
 		}
 		if err != nil {
 			klog.Errorf("Failed to get member cluster %s. err: %v", clusterName, err)
-			return false, err
+			return 0, err
 		}
-		klog.Infof("Waiting for the member cluster object %s to be deleted", clusterName)
-		return false, nil
+		return 1, nil
 	})
 	if err != nil {
 		klog.Errorf("Failed to delete member cluster object. cluster name: %s, error: %v", clusterName, err)
@@ -0,0 +1,134 @@
+package karmadactl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func TestCommandUnjoinOption_Complete(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        CommandUnjoinOption
+		args        []string
+		wantErr     bool
+		wantContext string
+	}{
+		{
+			name:    "no names, selector, or filename is an error",
+			opts:    CommandUnjoinOption{},
+			wantErr: true,
+		},
+		{
+			name:    "names and selector together are mutually exclusive",
+			opts:    CommandUnjoinOption{Selector: "env=prod"},
+			args:    []string{"member1"},
+			wantErr: true,
+		},
+		{
+			name:    "names and filename together are mutually exclusive",
+			opts:    CommandUnjoinOption{Filename: "clusters.yaml"},
+			args:    []string{"member1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid --wait-output is rejected",
+			opts:    CommandUnjoinOption{waitOutput: "bogus"},
+			args:    []string{"member1"},
+			wantErr: true,
+		},
+		{
+			name:        "single name with no --context falls back to --member-cluster-context",
+			opts:        CommandUnjoinOption{ClusterContext: "my-kubeconfig-context", waitOutput: "silent"},
+			args:        []string{"member1"},
+			wantErr:     false,
+			wantContext: "my-kubeconfig-context",
+		},
+		{
+			name:        "single name with explicit --context is left untouched",
+			opts:        CommandUnjoinOption{Context: "control-plane", ClusterContext: "my-kubeconfig-context", waitOutput: "silent"},
+			args:        []string{"member1"},
+			wantErr:     false,
+			wantContext: "control-plane",
+		},
+		{
+			name:    "selector alone is valid",
+			opts:    CommandUnjoinOption{Selector: "env=prod", waitOutput: "silent"},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			err := opts.Complete(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Complete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.wantContext != "" && opts.Context != tt.wantContext {
+				t.Errorf("Context = %q, want %q", opts.Context, tt.wantContext)
+			}
+		})
+	}
+}
+
+func TestCommandUnjoinOption_ResolveClusters(t *testing.T) {
+	t.Run("from positional names", func(t *testing.T) {
+		opts := &CommandUnjoinOption{targetNames: []string{"a", "b"}}
+		resolved, err := opts.ResolveClusters(karmadafake.NewSimpleClientset())
+		if err != nil {
+			t.Fatalf("ResolveClusters() error = %v", err)
+		}
+		if len(resolved) != 2 || resolved[0].ClusterName != "a" || resolved[1].ClusterName != "b" {
+			t.Fatalf("unexpected resolved clusters: %+v", resolved)
+		}
+	})
+
+	t.Run("from selector", func(t *testing.T) {
+		var objs []runtime.Object
+		objs = append(objs,
+			&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "staging-1", Labels: map[string]string{"env": "staging"}}},
+			&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "prod-1", Labels: map[string]string{"env": "prod"}}},
+		)
+		client := karmadafake.NewSimpleClientset(objs...)
+
+		opts := &CommandUnjoinOption{Selector: "env=staging"}
+		resolved, err := opts.ResolveClusters(client)
+		if err != nil {
+			t.Fatalf("ResolveClusters() error = %v", err)
+		}
+		if len(resolved) != 1 || resolved[0].ClusterName != "staging-1" {
+			t.Fatalf("unexpected resolved clusters: %+v", resolved)
+		}
+	})
+
+	t.Run("from manifest", func(t *testing.T) {
+		dir := t.TempDir()
+		manifestPath := filepath.Join(dir, "clusters.yaml")
+		manifest := "clusters:\n- name: member1\n  context: ctx1\n- name: member2\n"
+		if err := os.WriteFile(manifestPath, []byte(manifest), 0o644); err != nil {
+			t.Fatalf("failed to write manifest: %v", err)
+		}
+
+		opts := &CommandUnjoinOption{Filename: manifestPath}
+		resolved, err := opts.ResolveClusters(karmadafake.NewSimpleClientset())
+		if err != nil {
+			t.Fatalf("ResolveClusters() error = %v", err)
+		}
+		if len(resolved) != 2 {
+			t.Fatalf("expected 2 resolved clusters, got %d", len(resolved))
+		}
+		if resolved[0].ClusterName != "member1" || resolved[0].ClusterContext != "ctx1" {
+			t.Errorf("unexpected first cluster: %+v", resolved[0])
+		}
+		if resolved[1].ClusterName != "member2" || resolved[1].ClusterContext != "member2" {
+			t.Errorf("unexpected second cluster: %+v", resolved[1])
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package karmadactl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	kcutil "github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+func TestCommandUnjoinOption_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cluster     *clusterv1alpha1.Cluster
+		forceDelete bool
+		wantErr     bool
+	}{
+		{
+			name: "healthy cluster (Ready condition True) is allowed",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+				Status: clusterv1alpha1.ClusterStatus{
+					Conditions: []metav1.Condition{
+						{Type: readyConditionType, Status: metav1.ConditionTrue, Reason: "ClusterReady"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unhealthy cluster (Ready condition False) is allowed",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+				Status: clusterv1alpha1.ClusterStatus{
+					Conditions: []metav1.Condition{
+						{Type: readyConditionType, Status: metav1.ConditionFalse, Reason: "ClusterNotReady"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "cluster with no Ready condition yet is refused without --force",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cluster with no Ready condition yet is allowed with --force",
+			cluster: &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+			},
+			forceDelete: true,
+			wantErr:     false,
+		},
+		{
+			name:    "missing cluster object is allowed (nothing left to protect)",
+			cluster: nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objs []runtime.Object
+			if tt.cluster != nil {
+				objs = append(objs, tt.cluster)
+			}
+			client := karmadafake.NewSimpleClientset(objs...)
+
+			opts := &CommandUnjoinOption{ClusterName: "member1", forceDeletion: tt.forceDelete}
+			err := opts.Validate(client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDrainCluster_GracePeriodForcesWorkDeletion(t *testing.T) {
+	const executionSpace = "karmada-es-member1"
+
+	cluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}
+	work := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{Name: "stuck-work", Namespace: executionSpace}}
+	client := karmadafake.NewSimpleClientset(cluster, work)
+
+	// gracePeriod=0 means the very first poll should force-delete any Work still present.
+	err := drainCluster(client, "member1", executionSpace, 0, 5*time.Second, false, kcutil.NewProgressReporter(kcutil.WaitOutputSilent, nil))
+	if err != nil {
+		t.Fatalf("drainCluster() error = %v", err)
+	}
+
+	works, err := client.WorkV1alpha1().Works(executionSpace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list works: %v", err)
+	}
+	if len(works.Items) != 0 {
+		t.Fatalf("expected all Works to be force-deleted after grace period, got %d remaining", len(works.Items))
+	}
+
+	updated, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+	var tainted bool
+	for _, taint := range updated.Spec.Taints {
+		if taint.Key == unjoinDrainTaintKey {
+			tainted = true
+		}
+	}
+	if !tainted {
+		t.Fatalf("expected cluster to be tainted for draining")
+	}
+}
+
+func TestRunUnjoinBatch_BucketsValidateRefusalsAsSkipped(t *testing.T) {
+	// No Ready condition yet: Validate refuses this cluster without --force.
+	cluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}}
+	karmadaClient := karmadafake.NewSimpleClientset(cluster)
+	kubeClient := kubefake.NewSimpleClientset()
+
+	clusterOpts := []CommandUnjoinOption{{ClusterName: "member1", waitOutput: "silent"}}
+	report := runUnjoinBatch(nil, karmadaClient, kubeClient, clusterOpts, 1, kcutil.NewProgressReporter(kcutil.WaitOutputSilent, nil))
+
+	if len(report.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(report.Results))
+	}
+	if !report.Results[0].Skipped {
+		t.Fatalf("expected cluster to be skipped, got %+v", report.Results[0])
+	}
+	if err := report.Err(); err != nil {
+		t.Fatalf("Err() should not count skipped clusters as failures, got %v", err)
+	}
+}
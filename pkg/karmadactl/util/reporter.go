@@ -0,0 +1,107 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+)
+
+// NewProgressReporter returns the ProgressReporter for mode, writing to out.
+// Writes are serialized so a single reporter can be shared safely across
+// concurrently running stages (e.g. a batch unjoin processing several
+// clusters in parallel).
+func NewProgressReporter(mode WaitOutputMode, out io.Writer) ProgressReporter {
+	sw := &syncWriter{out: out}
+
+	switch mode {
+	case WaitOutputSpinner:
+		return &spinnerReporter{out: sw}
+	case WaitOutputProgress:
+		return newTableReporter(sw)
+	case WaitOutputJSON:
+		return &jsonReporter{enc: json.NewEncoder(sw)}
+	case WaitOutputSilent:
+		fallthrough
+	default:
+		return silentReporter{}
+	}
+}
+
+// syncWriter serializes writes from multiple goroutines onto a single io.Writer.
+type syncWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.out.Write(p)
+}
+
+// silentReporter discards every event; used for scripted/non-interactive runs that don't want noise.
+type silentReporter struct{}
+
+func (silentReporter) Report(ProgressEvent) {}
+
+// spinnerReporter writes one line per event: "<stage>: <remaining> remaining (<elapsed>)",
+// or "<stage>: done (<elapsed>)" once the stage completes. Earlier lines are not overwritten.
+type spinnerReporter struct {
+	out io.Writer
+}
+
+func (s *spinnerReporter) Report(event ProgressEvent) {
+	if event.Done {
+		fmt.Fprintf(s.out, "%s: done (%s)\n", event.Stage, event.Elapsed)
+		return
+	}
+	fmt.Fprintf(s.out, "%s: %d remaining (%s)\n", event.Stage, event.Remaining, event.Elapsed)
+}
+
+// tableReporter renders one tab-aligned row per observed change in remaining count.
+type tableReporter struct {
+	mu     sync.Mutex
+	out    *tabwriter.Writer
+	header bool
+	last   map[string]int
+}
+
+func newTableReporter(w io.Writer) *tableReporter {
+	return &tableReporter{out: tabwriter.NewWriter(w, 0, 0, 2, ' ', 0), last: map[string]int{}}
+}
+
+func (t *tableReporter) Report(event ProgressEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.header {
+		fmt.Fprintln(t.out, "STAGE\tELAPSED\tREMAINING\tSTATUS")
+		t.header = true
+	}
+
+	if last, ok := t.last[event.Stage]; ok && last == event.Remaining && !event.Done {
+		return
+	}
+	t.last[event.Stage] = event.Remaining
+
+	status := "waiting"
+	if event.Done {
+		status = "done"
+	}
+	fmt.Fprintf(t.out, "%s\t%s\t%d\t%s\n", event.Stage, event.Elapsed, event.Remaining, status)
+	t.out.Flush()
+}
+
+// jsonReporter emits each event as a line of newline-delimited JSON, for CI/scripted consumption.
+type jsonReporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (j *jsonReporter) Report(event ProgressEvent) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	_ = j.enc.Encode(event)
+}
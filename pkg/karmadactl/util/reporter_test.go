@@ -0,0 +1,41 @@
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNewProgressReporter_Silent(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewProgressReporter(WaitOutputSilent, &buf)
+	reporter.Report(ProgressEvent{Stage: "stage", Remaining: 1})
+	if buf.Len() != 0 {
+		t.Errorf("silent reporter should not write anything, got %q", buf.String())
+	}
+}
+
+func TestNewProgressReporter_TableDedupesUnchangedRemaining(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewProgressReporter(WaitOutputProgress, &buf)
+
+	reporter.Report(ProgressEvent{Stage: "stage", Remaining: 2})
+	reporter.Report(ProgressEvent{Stage: "stage", Remaining: 2}) // unchanged, should be skipped
+	reporter.Report(ProgressEvent{Stage: "stage", Remaining: 0, Done: true})
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	// header + 2 distinct observations (remaining=2, then done).
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines (header + 2 rows), got %d: %q", len(lines), buf.String())
+	}
+}
+
+func TestNewProgressReporter_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewProgressReporter(WaitOutputJSON, &buf)
+	reporter.Report(ProgressEvent{Stage: "stage", Remaining: 1})
+
+	if !strings.Contains(buf.String(), `"stage":"stage"`) {
+		t.Errorf("expected JSON output to contain the stage field, got %q", buf.String())
+	}
+}
@@ -0,0 +1,67 @@
+// Package util holds helpers shared across karmadactl commands, starting
+// with a pluggable progress reporter for long-running polling operations
+// (e.g. waiting for a namespace or object to be deleted).
+package util
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// WaitOutputMode selects how a ProgressReporter renders the events it receives.
+type WaitOutputMode string
+
+const (
+	// WaitOutputSilent discards progress events entirely.
+	WaitOutputSilent WaitOutputMode = "silent"
+	// WaitOutputSpinner writes one plain line per event, suited to an interactive terminal.
+	// Lines are appended, not redrawn in place.
+	WaitOutputSpinner WaitOutputMode = "spinner"
+	// WaitOutputProgress renders one row per observed change in a table, suited to CI logs.
+	WaitOutputProgress WaitOutputMode = "progress"
+	// WaitOutputJSON renders each event as a line of newline-delimited JSON, for machine consumption.
+	WaitOutputJSON WaitOutputMode = "json"
+)
+
+// ParseWaitOutputMode validates s against the supported WaitOutputMode values.
+func ParseWaitOutputMode(s string) (WaitOutputMode, error) {
+	switch mode := WaitOutputMode(s); mode {
+	case WaitOutputSilent, WaitOutputSpinner, WaitOutputProgress, WaitOutputJSON:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unsupported wait-output mode %q, must be one of: silent, spinner, progress, json", s)
+	}
+}
+
+// ProgressEvent is a single stage update emitted while waiting for a resource
+// to reach its desired state, e.g. how many Work objects are still pending deletion.
+type ProgressEvent struct {
+	Stage     string        `json:"stage"`
+	Remaining int           `json:"remaining"`
+	Elapsed   time.Duration `json:"elapsed"`
+	Done      bool          `json:"done"`
+}
+
+// ProgressReporter streams the ProgressEvents produced by PollUntilComplete.
+type ProgressReporter interface {
+	Report(event ProgressEvent)
+}
+
+// PollUntilComplete polls countRemaining at the given interval until it
+// returns zero remaining or timeout expires, reporting each observation
+// under stage through reporter. It wraps wait.PollImmediate, so it returns
+// wait.ErrWaitTimeout on timeout.
+func PollUntilComplete(reporter ProgressReporter, stage string, interval, timeout time.Duration, countRemaining func() (int, error)) error {
+	start := time.Now()
+	return wait.PollImmediate(interval, timeout, func() (bool, error) {
+		remaining, err := countRemaining()
+		if err != nil {
+			return false, err
+		}
+		done := remaining == 0
+		reporter.Report(ProgressEvent{Stage: stage, Remaining: remaining, Elapsed: time.Since(start).Round(time.Second), Done: done})
+		return done, nil
+	})
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestParseWaitOutputMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    WaitOutputMode
+		wantErr bool
+	}{
+		{in: "silent", want: WaitOutputSilent},
+		{in: "spinner", want: WaitOutputSpinner},
+		{in: "progress", want: WaitOutputProgress},
+		{in: "json", want: WaitOutputJSON},
+		{in: "bogus", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseWaitOutputMode(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWaitOutputMode(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ParseWaitOutputMode(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+type recordingReporter struct {
+	events []ProgressEvent
+}
+
+func (r *recordingReporter) Report(event ProgressEvent) {
+	r.events = append(r.events, event)
+}
+
+func TestPollUntilComplete(t *testing.T) {
+	t.Run("reports until remaining reaches zero", func(t *testing.T) {
+		reporter := &recordingReporter{}
+		remaining := []int{2, 1, 0}
+		call := 0
+
+		err := PollUntilComplete(reporter, "stage", 1*time.Millisecond, time.Second, func() (int, error) {
+			n := remaining[call]
+			call++
+			return n, nil
+		})
+		if err != nil {
+			t.Fatalf("PollUntilComplete() error = %v", err)
+		}
+		if call != len(remaining) {
+			t.Fatalf("expected countRemaining to be called %d times, got %d", len(remaining), call)
+		}
+		last := reporter.events[len(reporter.events)-1]
+		if !last.Done || last.Remaining != 0 {
+			t.Errorf("expected final event to be Done with Remaining=0, got %+v", last)
+		}
+	})
+
+	t.Run("times out if remaining never reaches zero", func(t *testing.T) {
+		reporter := &recordingReporter{}
+		err := PollUntilComplete(reporter, "stage", 1*time.Millisecond, 5*time.Millisecond, func() (int, error) {
+			return 1, nil
+		})
+		if !errors.Is(err, wait.ErrWaitTimeout) {
+			t.Fatalf("expected wait.ErrWaitTimeout, got %v", err)
+		}
+	})
+
+	t.Run("propagates countRemaining errors", func(t *testing.T) {
+		reporter := &recordingReporter{}
+		boom := errors.New("boom")
+		err := PollUntilComplete(reporter, "stage", 1*time.Millisecond, time.Second, func() (int, error) {
+			return 0, boom
+		})
+		if !errors.Is(err, boom) {
+			t.Fatalf("expected error to wrap %v, got %v", boom, err)
+		}
+	})
+}